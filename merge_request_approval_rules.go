@@ -0,0 +1,293 @@
+package gitlab
+
+import (
+	"fmt"
+)
+
+// ProjectApprovalRule represents a GitLab project-level merge request
+// approval rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#project-level-mr-approvals
+type ProjectApprovalRule struct {
+	ID                   int                `json:"id"`
+	Name                 string             `json:"name"`
+	RuleType             string             `json:"rule_type"`
+	EligibleApprovers    []*BasicUser       `json:"eligible_approvers"`
+	ApprovalsRequired    int                `json:"approvals_required"`
+	Users                []*BasicUser       `json:"users"`
+	Groups               []*Group           `json:"groups"`
+	ContainsHiddenGroups bool               `json:"contains_hidden_groups"`
+	ProtectedBranches    []*ProtectedBranch `json:"protected_branches"`
+}
+
+func (p ProjectApprovalRule) String() string {
+	return Stringify(p)
+}
+
+// MergeRequestApprovalRule represents a GitLab merge request-level approval
+// rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#merge-request-level-mr-approvals
+type MergeRequestApprovalRule struct {
+	ID                   int                       `json:"id"`
+	Name                 string                    `json:"name"`
+	RuleType             string                    `json:"rule_type"`
+	EligibleApprovers    []*BasicUser              `json:"eligible_approvers"`
+	ApprovalsRequired    int                       `json:"approvals_required"`
+	Users                []*BasicUser              `json:"users"`
+	Groups               []*Group                  `json:"groups"`
+	ContainsHiddenGroups bool                      `json:"contains_hidden_groups"`
+	ApprovedBy           []*BasicUser              `json:"approved_by"`
+	Approved             bool                      `json:"approved"`
+	SourceRule           *MergeRequestApprovalRule `json:"source_rule"`
+}
+
+func (m MergeRequestApprovalRule) String() string {
+	return Stringify(m)
+}
+
+// GetProjectApprovalRules requests information about a project's approval
+// rules.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-project-level-rules
+func (s *MergeRequestApprovalsService) GetProjectApprovalRules(pid interface{}, options ...OptionFunc) ([]*ProjectApprovalRule, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/approval_rules", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var par []*ProjectApprovalRule
+	resp, err := s.client.Do(req, &par)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return par, resp, err
+}
+
+// CreateProjectApprovalRuleOptions represents the available
+// CreateProjectApprovalRule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#create-project-level-rule
+type CreateProjectApprovalRuleOptions struct {
+	Name               *string `url:"name,omitempty" json:"name,omitempty"`
+	ApprovalsRequired  *int    `url:"approvals_required,omitempty" json:"approvals_required,omitempty"`
+	UserIDs            []int   `url:"user_ids,omitempty" json:"user_ids,omitempty"`
+	GroupIDs           []int   `url:"group_ids,omitempty" json:"group_ids,omitempty"`
+	ProtectedBranchIDs []int   `url:"protected_branch_ids,omitempty" json:"protected_branch_ids,omitempty"`
+}
+
+// CreateProjectApprovalRule creates a new project-level approval rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#create-project-level-rule
+func (s *MergeRequestApprovalsService) CreateProjectApprovalRule(pid interface{}, opt *CreateProjectApprovalRuleOptions, options ...OptionFunc) (*ProjectApprovalRule, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/approval_rules", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	par := new(ProjectApprovalRule)
+	resp, err := s.client.Do(req, par)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return par, resp, err
+}
+
+// UpdateProjectApprovalRuleOptions represents the available
+// UpdateProjectApprovalRule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#update-project-level-rule
+type UpdateProjectApprovalRuleOptions struct {
+	Name               *string `url:"name,omitempty" json:"name,omitempty"`
+	ApprovalsRequired  *int    `url:"approvals_required,omitempty" json:"approvals_required,omitempty"`
+	UserIDs            []int   `url:"user_ids,omitempty" json:"user_ids,omitempty"`
+	GroupIDs           []int   `url:"group_ids,omitempty" json:"group_ids,omitempty"`
+	ProtectedBranchIDs []int   `url:"protected_branch_ids,omitempty" json:"protected_branch_ids,omitempty"`
+}
+
+// UpdateProjectApprovalRule updates an existing project-level approval rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#update-project-level-rule
+func (s *MergeRequestApprovalsService) UpdateProjectApprovalRule(pid interface{}, approvalRule int, opt *UpdateProjectApprovalRuleOptions, options ...OptionFunc) (*ProjectApprovalRule, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/approval_rules/%d", pathEscape(project), approvalRule)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	par := new(ProjectApprovalRule)
+	resp, err := s.client.Do(req, par)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return par, resp, err
+}
+
+// DeleteProjectApprovalRule deletes a project-level approval rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#delete-project-level-rule
+func (s *MergeRequestApprovalsService) DeleteProjectApprovalRule(pid interface{}, approvalRule int, options ...OptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/approval_rules/%d", pathEscape(project), approvalRule)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// GetMergeRequestApprovalRules requests information about a merge request's
+// approval rules.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-merge-request-level-rules
+func (s *MergeRequestApprovalsService) GetMergeRequestApprovalRules(pid interface{}, mergeRequestIID int, options ...OptionFunc) ([]*MergeRequestApprovalRule, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/approval_rules", pathEscape(project), mergeRequestIID)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mar []*MergeRequestApprovalRule
+	resp, err := s.client.Do(req, &mar)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mar, resp, err
+}
+
+// CreateMergeRequestApprovalRuleOptions represents the available
+// CreateMergeRequestApprovalRule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#create-merge-request-level-rule
+type CreateMergeRequestApprovalRuleOptions struct {
+	Name                  *string `url:"name,omitempty" json:"name,omitempty"`
+	ApprovalsRequired     *int    `url:"approvals_required,omitempty" json:"approvals_required,omitempty"`
+	ApprovalProjectRuleID *int    `url:"approval_project_rule_id,omitempty" json:"approval_project_rule_id,omitempty"`
+	UserIDs               []int   `url:"user_ids,omitempty" json:"user_ids,omitempty"`
+	GroupIDs              []int   `url:"group_ids,omitempty" json:"group_ids,omitempty"`
+}
+
+// CreateMergeRequestApprovalRule creates a new merge request-level approval
+// rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#create-merge-request-level-rule
+func (s *MergeRequestApprovalsService) CreateMergeRequestApprovalRule(pid interface{}, mergeRequestIID int, opt *CreateMergeRequestApprovalRuleOptions, options ...OptionFunc) (*MergeRequestApprovalRule, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/approval_rules", pathEscape(project), mergeRequestIID)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mar := new(MergeRequestApprovalRule)
+	resp, err := s.client.Do(req, mar)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mar, resp, err
+}
+
+// UpdateMergeRequestApprovalRuleOptions represents the available
+// UpdateMergeRequestApprovalRule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#update-merge-request-level-rule
+type UpdateMergeRequestApprovalRuleOptions struct {
+	Name              *string `url:"name,omitempty" json:"name,omitempty"`
+	ApprovalsRequired *int    `url:"approvals_required,omitempty" json:"approvals_required,omitempty"`
+	UserIDs           []int   `url:"user_ids,omitempty" json:"user_ids,omitempty"`
+	GroupIDs          []int   `url:"group_ids,omitempty" json:"group_ids,omitempty"`
+}
+
+// UpdateMergeRequestApprovalRule updates an existing merge request-level
+// approval rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#update-merge-request-level-rule
+func (s *MergeRequestApprovalsService) UpdateMergeRequestApprovalRule(pid interface{}, mergeRequestIID int, approvalRule int, opt *UpdateMergeRequestApprovalRuleOptions, options ...OptionFunc) (*MergeRequestApprovalRule, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/approval_rules/%d", pathEscape(project), mergeRequestIID, approvalRule)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mar := new(MergeRequestApprovalRule)
+	resp, err := s.client.Do(req, mar)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mar, resp, err
+}
+
+// DeleteMergeRequestApprovalRule deletes a merge request-level approval
+// rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#delete-merge-request-level-rule
+func (s *MergeRequestApprovalsService) DeleteMergeRequestApprovalRule(pid interface{}, mergeRequestIID int, approvalRule int, options ...OptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/approval_rules/%d", pathEscape(project), mergeRequestIID, approvalRule)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,176 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetProjectApprovalRules(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/approval_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":1,"name":"security","rule_type":"regular","approvals_required":2}]`)
+	})
+
+	rules, _, err := client.MergeRequestApprovals.GetProjectApprovalRules(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*ProjectApprovalRule{{ID: 1, Name: "security", RuleType: "regular", ApprovalsRequired: 2}}
+	if !reflect.DeepEqual(want, rules) {
+		t.Errorf("GetProjectApprovalRules returned %+v, want %+v", rules, want)
+	}
+}
+
+func TestCreateProjectApprovalRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/approval_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id":1,"name":"security","rule_type":"regular","approvals_required":2}`)
+	})
+
+	opt := &CreateProjectApprovalRuleOptions{
+		Name:              String("security"),
+		ApprovalsRequired: Int(2),
+	}
+
+	rule, _, err := client.MergeRequestApprovals.CreateProjectApprovalRule(1, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ProjectApprovalRule{ID: 1, Name: "security", RuleType: "regular", ApprovalsRequired: 2}
+	if !reflect.DeepEqual(want, rule) {
+		t.Errorf("CreateProjectApprovalRule returned %+v, want %+v", rule, want)
+	}
+}
+
+func TestUpdateProjectApprovalRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/approval_rules/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"id":5,"name":"security","rule_type":"regular","approvals_required":3}`)
+	})
+
+	opt := &UpdateProjectApprovalRuleOptions{
+		ApprovalsRequired: Int(3),
+	}
+
+	rule, _, err := client.MergeRequestApprovals.UpdateProjectApprovalRule(1, 5, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ProjectApprovalRule{ID: 5, Name: "security", RuleType: "regular", ApprovalsRequired: 3}
+	if !reflect.DeepEqual(want, rule) {
+		t.Errorf("UpdateProjectApprovalRule returned %+v, want %+v", rule, want)
+	}
+}
+
+func TestDeleteProjectApprovalRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/approval_rules/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.MergeRequestApprovals.DeleteProjectApprovalRule(1, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("DeleteProjectApprovalRule returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestGetMergeRequestApprovalRules(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/2/approval_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":1,"name":"security","rule_type":"regular","approvals_required":2,"approved":false}]`)
+	})
+
+	rules, _, err := client.MergeRequestApprovals.GetMergeRequestApprovalRules(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*MergeRequestApprovalRule{{ID: 1, Name: "security", RuleType: "regular", ApprovalsRequired: 2}}
+	if !reflect.DeepEqual(want, rules) {
+		t.Errorf("GetMergeRequestApprovalRules returned %+v, want %+v", rules, want)
+	}
+}
+
+func TestCreateMergeRequestApprovalRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/2/approval_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id":1,"name":"security","rule_type":"regular","approvals_required":2}`)
+	})
+
+	opt := &CreateMergeRequestApprovalRuleOptions{
+		Name:              String("security"),
+		ApprovalsRequired: Int(2),
+	}
+
+	rule, _, err := client.MergeRequestApprovals.CreateMergeRequestApprovalRule(1, 2, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &MergeRequestApprovalRule{ID: 1, Name: "security", RuleType: "regular", ApprovalsRequired: 2}
+	if !reflect.DeepEqual(want, rule) {
+		t.Errorf("CreateMergeRequestApprovalRule returned %+v, want %+v", rule, want)
+	}
+}
+
+func TestUpdateMergeRequestApprovalRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/2/approval_rules/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"id":5,"name":"security","rule_type":"regular","approvals_required":3}`)
+	})
+
+	opt := &UpdateMergeRequestApprovalRuleOptions{
+		ApprovalsRequired: Int(3),
+	}
+
+	rule, _, err := client.MergeRequestApprovals.UpdateMergeRequestApprovalRule(1, 2, 5, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &MergeRequestApprovalRule{ID: 5, Name: "security", RuleType: "regular", ApprovalsRequired: 3}
+	if !reflect.DeepEqual(want, rule) {
+		t.Errorf("UpdateMergeRequestApprovalRule returned %+v, want %+v", rule, want)
+	}
+}
+
+func TestDeleteMergeRequestApprovalRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/2/approval_rules/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.MergeRequestApprovals.DeleteMergeRequestApprovalRule(1, 2, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("DeleteMergeRequestApprovalRule returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
@@ -0,0 +1,104 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetConfiguration(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/2/approvals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"id": 5,
+			"project_id": 1,
+			"title": "Approve all the things",
+			"approvals_required": 2,
+			"approvals_left": 1,
+			"approved_by": [{"user": {"id": 5, "username": "john"}}]
+		}`)
+	})
+
+	approvals, _, err := client.MergeRequestApprovals.GetConfiguration(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if approvals.ApprovalsRequired != 2 || approvals.ApprovalsLeft != 1 {
+		t.Errorf("GetConfiguration returned %+v, want ApprovalsRequired=2, ApprovalsLeft=1", approvals)
+	}
+	if len(approvals.ApprovedBy) != 1 || approvals.ApprovedBy[0].User.Username != "john" {
+		t.Errorf("GetConfiguration returned approved_by %+v, want a single approver named john", approvals.ApprovedBy)
+	}
+}
+
+func TestGetApprovalState(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/2/approval_state", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"rules": [
+				{
+					"id": 1,
+					"name": "security",
+					"rule_type": "regular",
+					"approvals_required": 2,
+					"approved": false,
+					"approved_by": []
+				}
+			]
+		}`)
+	})
+
+	state, _, err := client.MergeRequestApprovals.GetApprovalState(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &MergeRequestApprovalState{
+		Rules: []*ApprovalRule{
+			{ID: 1, Name: "security", RuleType: "regular", ApprovalsRequired: 2, ApprovedBy: []*BasicUser{}},
+		},
+	}
+	if !reflect.DeepEqual(want, state) {
+		t.Errorf("GetApprovalState returned %+v, want %+v", state, want)
+	}
+}
+
+func TestGetProjectApprovalConfiguration(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/approvals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"approvers": [],
+			"approver_groups": [],
+			"approvals_before_merge": 2,
+			"reset_approvals_on_push": true,
+			"disable_overriding_approvers_per_merge_request": false,
+			"merge_requests_author_approval": false,
+			"merge_requests_disable_committers_approval": false,
+			"require_password_to_approve": true
+		}`)
+	})
+
+	approvals, _, err := client.MergeRequestApprovals.GetProjectApprovalConfiguration(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &ProjectApprovals{
+		Approvers:                []*MergeRequestApproverUser{},
+		ApproverGroups:           []*MergeRequestApproverGroup{},
+		ApprovalsBeforeMerge:     2,
+		ResetApprovalsOnPush:     true,
+		RequirePasswordToApprove: true,
+	}
+	if !reflect.DeepEqual(want, approvals) {
+		t.Errorf("GetProjectApprovalConfiguration returned %+v, want %+v", approvals, want)
+	}
+}